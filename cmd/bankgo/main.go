@@ -0,0 +1,91 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	_ "github.com/lib/pq"
+
+	"github.com/Flamme97/bankGO/pkg/api"
+	"github.com/Flamme97/bankGO/pkg/mail"
+	"github.com/Flamme97/bankGO/pkg/model"
+	"github.com/Flamme97/bankGO/pkg/storage"
+)
+
+func newMailer() mail.Mailer {
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		return mail.LogMailer{}
+	}
+	return mail.NewSMTPMailer(host, os.Getenv("SMTP_PORT"), os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), os.Getenv("SMTP_FROM"))
+}
+
+func seedAccount(store storage.Storage, fname, lname, pw string) *model.Account {
+	acc, err := model.NewAccount(fname, lname, pw)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := store.CreateAccount(acc); err != nil {
+		log.Fatal(err)
+	}
+
+	return acc
+}
+
+func seedAccounts(s storage.Storage) {
+	seedAccount(s, "Obl", "gg", "passwordbreaker")
+
+}
+
+// seedAdminAccount bootstraps the first admin account when the account
+// table is still empty, using ADMIN_API_KEY as its password.
+func seedAdminAccount(s storage.Storage) {
+	accounts, err := s.GetAccounts()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(accounts) > 0 {
+		return
+	}
+
+	adminKey := os.Getenv("ADMIN_API_KEY")
+	if adminKey == "" {
+		log.Println("ADMIN_API_KEY not set, skipping admin bootstrap")
+		return
+	}
+
+	admin, err := model.NewAccountWithRole("admin", "admin", adminKey, model.RoleAdmin)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := s.CreateAccount(admin); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("seeded admin account, number=%d\n", admin.Number)
+}
+
+func main() {
+	seed := flag.Bool("seed", false, "seed the db")
+
+	flag.Parse()
+
+	store, err := storage.NewPostgresStore()
+
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := store.Init(); err != nil {
+		log.Fatal(err)
+	}
+	seedAdminAccount(store)
+	if *seed {
+		fmt.Println("seeding the database")
+		// seed stuff
+		seedAccounts(store)
+	}
+
+	server := api.NewAPIServer(":3000", store, newMailer())
+	server.Run()
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+
+	"github.com/Flamme97/bankGO/pkg/auth"
+	"github.com/Flamme97/bankGO/pkg/model"
+)
+
+func (s *APIServer) HandleLogin(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != "POST" {
+		PermissionsDenied(w)
+		return nil
+	}
+
+	var req model.LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return err
+	}
+
+	acc, err := s.store.GetAccountByNumber(int(req.Number))
+	if err != nil {
+		return err // handle this reponse as json
+	}
+
+	if !acc.ValidatePW(req.Password) {
+		return fmt.Errorf("failed to login")
+	}
+
+	access, refresh, err := auth.IssueTokenPair(acc.Number, acc.Role)
+	if err != nil {
+		return err
+	}
+
+	resp := model.LoginReponse{
+		Number:       acc.Number,
+		AccessToken:  access,
+		RefreshToken: refresh,
+	}
+
+	return WriteJSON(w, http.StatusOK, resp)
+}
+
+// HandleRefresh rotates a refresh token: the presented refresh jti is
+// revoked and a brand new access/refresh pair is issued. Presenting an
+// already-revoked (i.e. already-rotated) refresh token is treated as
+// possible replay and rejected.
+func (s *APIServer) HandleRefresh(w http.ResponseWriter, r *http.Request) error {
+	var req model.RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return err
+	}
+
+	token, err := auth.ValidateJWT(req.RefreshToken)
+	if err != nil || !token.Valid {
+		PermissionsDenied(w)
+		return nil
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["typ"] != auth.TypeRefresh {
+		PermissionsDenied(w)
+		return nil
+	}
+
+	jti, _ := claims["jti"].(string)
+	revoked, err := s.store.IsTokenRevoked(jti)
+	if err != nil {
+		return err
+	}
+	if revoked {
+		PermissionsDenied(w)
+		return nil
+	}
+
+	number, ok := claims["accountNumber"].(float64)
+	if !ok {
+		PermissionsDenied(w)
+		return nil
+	}
+	acc, err := s.store.GetAccountByNumber(int(number))
+	if err != nil {
+		return err
+	}
+
+	if err := s.store.RevokeToken(jti, auth.ExpiresAt(claims)); err != nil {
+		return err
+	}
+
+	access, refresh, err := auth.IssueTokenPair(acc.Number, acc.Role)
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, model.LoginReponse{
+		Number:       acc.Number,
+		AccessToken:  access,
+		RefreshToken: refresh,
+	})
+}
+
+// HandleLogout revokes the caller's current access token together with
+// the refresh token presented in the request body.
+func (s *APIServer) HandleLogout(w http.ResponseWriter, r *http.Request) error {
+	claims, ok := auth.ClaimsFromContext(r)
+	if !ok {
+		PermissionsDenied(w)
+		return nil
+	}
+	if accessJTI, ok := claims["jti"].(string); ok {
+		if err := s.store.RevokeToken(accessJTI, auth.ExpiresAt(claims)); err != nil {
+			return err
+		}
+	}
+
+	var req model.LogoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err == nil && req.RefreshToken != "" {
+		if refreshToken, err := auth.ValidateJWT(req.RefreshToken); err == nil {
+			if refreshClaims, ok := refreshToken.Claims.(jwt.MapClaims); ok {
+				if refreshJTI, ok := refreshClaims["jti"].(string); ok {
+					if err := s.store.RevokeToken(refreshJTI, auth.ExpiresAt(refreshClaims)); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return WriteJSON(w, http.StatusOK, map[string]string{"status": "logged out"})
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Flamme97/bankGO/pkg/auth"
+	"github.com/Flamme97/bankGO/pkg/model"
+)
+
+const passwordResetTTL = 30 * time.Minute
+
+// genericResetResponse is returned for every /auth/password-reset/request
+// call, whether or not the account number exists, so the endpoint can't
+// be used to enumerate registered accounts.
+var genericResetResponse = map[string]string{"status": "if that account exists, a reset email has been sent"}
+
+// HandlePasswordResetRequest issues a reset token for the given account
+// number and emails it via s.mailer. Rate-limited by both account
+// number and client IP to slow down enumeration/spam attempts.
+func (s *APIServer) HandlePasswordResetRequest(w http.ResponseWriter, r *http.Request) error {
+	var req model.PasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return err
+	}
+
+	if !s.resetLimiter.Allow("ip:"+clientIP(r)) || !s.resetLimiter.Allow(fmt.Sprintf("acct:%d", req.Number)) {
+		return WriteJSON(w, http.StatusOK, genericResetResponse)
+	}
+
+	acc, err := s.store.GetAccountByNumber(int(req.Number))
+	if err != nil {
+		return WriteJSON(w, http.StatusOK, genericResetResponse)
+	}
+
+	token, hash, err := auth.GenerateResetToken()
+	if err != nil {
+		return err
+	}
+	if err := s.store.CreatePasswordReset(acc.ID, hash, time.Now().Add(passwordResetTTL)); err != nil {
+		return err
+	}
+
+	body := fmt.Sprintf("Use this token to reset your bankGO password: %s\nIt expires in 30 minutes.", token)
+	if err := s.mailer.Send(acc.Email, "Reset your bankGO password", body); err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, genericResetResponse)
+}
+
+// HandlePasswordResetConfirm redeems a reset token, rejecting it if it's
+// unknown, expired, or already consumed, then rewrites the account's
+// password hash.
+func (s *APIServer) HandlePasswordResetConfirm(w http.ResponseWriter, r *http.Request) error {
+	var req model.PasswordResetConfirmRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return err
+	}
+
+	reset, err := s.store.GetPasswordResetByTokenHash(auth.HashResetToken(req.Token))
+	if err != nil {
+		return fmt.Errorf("invalid or expired reset token")
+	}
+	if reset.ConsumedAt.Valid {
+		return fmt.Errorf("invalid or expired reset token")
+	}
+	if time.Now().After(reset.ExpiresAt) {
+		return fmt.Errorf("invalid or expired reset token")
+	}
+
+	hash, err := auth.HashPassword(req.NewPassword)
+	if err != nil {
+		return err
+	}
+	if err := s.store.UpdatePasswordHash(reset.AccountID, hash); err != nil {
+		return err
+	}
+	if err := s.store.ConsumePasswordReset(reset.ID); err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, map[string]string{"status": "password updated"})
+}
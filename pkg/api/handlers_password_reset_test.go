@@ -0,0 +1,122 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Flamme97/bankGO/pkg/mail"
+	"github.com/Flamme97/bankGO/pkg/model"
+)
+
+type capturingMailer struct {
+	to, subject, body string
+}
+
+func (m *capturingMailer) Send(to, subject, body string) error {
+	m.to, m.subject, m.body = to, subject, body
+	return nil
+}
+
+func TestPasswordResetRequestIsGenericForUnknownAccounts(t *testing.T) {
+	s, _ := newTestServer(t)
+	mailer := &capturingMailer{}
+	s.mailer = mailer
+
+	body, _ := json.Marshal(model.PasswordResetRequest{Number: 99999})
+	req := httptest.NewRequest(http.MethodPost, "/auth/password-reset/request", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	makeHTTPHandleFunc(s.HandlePasswordResetRequest)(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if mailer.to != "" {
+		t.Errorf("mailer.Send called for unknown account: %+v", mailer)
+	}
+}
+
+func TestPasswordResetRequestThenConfirm(t *testing.T) {
+	s, store := newTestServer(t)
+	mailer := &mail.LogMailer{}
+	_ = mailer
+
+	acc, _ := model.NewAccount("Jane", "Doe", "old-password")
+	acc.Email = "jane@example.com"
+	store.CreateAccount(acc)
+
+	captured := &capturingMailer{}
+	s.mailer = captured
+
+	reqBody, _ := json.Marshal(model.PasswordResetRequest{Number: acc.Number})
+	req := httptest.NewRequest(http.MethodPost, "/auth/password-reset/request", bytes.NewReader(reqBody))
+	rec := httptest.NewRecorder()
+	makeHTTPHandleFunc(s.HandlePasswordResetRequest)(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("request status = %d, want %d (body: %s)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if captured.to != acc.Email {
+		t.Fatalf("mailer.Send to = %q, want %q", captured.to, acc.Email)
+	}
+
+	token := extractToken(captured.body)
+	if token == "" {
+		t.Fatalf("could not find token in mail body: %q", captured.body)
+	}
+
+	tests := []struct {
+		name       string
+		token      string
+		wantStatus int
+	}{
+		{name: "wrong token rejected", token: "not-the-real-token", wantStatus: http.StatusBadRequest},
+		{name: "correct token accepted", token: token, wantStatus: http.StatusOK},
+		{name: "token cannot be reused", token: token, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confirmBody, _ := json.Marshal(model.PasswordResetConfirmRequest{Token: tt.token, NewPassword: "new-password"})
+			confirmReq := httptest.NewRequest(http.MethodPost, "/auth/password-reset/confirm", bytes.NewReader(confirmBody))
+			confirmRec := httptest.NewRecorder()
+			makeHTTPHandleFunc(s.HandlePasswordResetConfirm)(confirmRec, confirmReq)
+
+			if confirmRec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", confirmRec.Code, tt.wantStatus, confirmRec.Body.String())
+			}
+		})
+	}
+
+	updated, _ := store.GetAccountByID(acc.ID)
+	if !updated.ValidatePW("new-password") {
+		t.Errorf("account password was not updated")
+	}
+}
+
+// extractToken pulls the token out of the plain-text mail body built in
+// HandlePasswordResetRequest.
+func extractToken(body string) string {
+	const prefix = "Use this token to reset your bankGO password: "
+	idx := indexOf(body, prefix)
+	if idx == -1 {
+		return ""
+	}
+	rest := body[idx+len(prefix):]
+	if nl := indexOf(rest, "\n"); nl != -1 {
+		rest = rest[:nl]
+	}
+	return rest
+}
+
+func indexOf(s, substr string) int {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if s[i:i+len(substr)] == substr {
+			return i
+		}
+	}
+	return -1
+}
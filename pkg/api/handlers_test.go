@@ -0,0 +1,150 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/Flamme97/bankGO/pkg/auth"
+	"github.com/Flamme97/bankGO/pkg/mail"
+	"github.com/Flamme97/bankGO/pkg/model"
+	"github.com/Flamme97/bankGO/pkg/storage"
+)
+
+func newTestServer(t *testing.T) (*APIServer, storage.Storage) {
+	t.Helper()
+	t.Setenv("JWT_SECRET", "test-secret")
+	store := storage.NewMemoryStore()
+	return NewAPIServer(":0", store, mail.LogMailer{}), store
+}
+
+func TestHandleLogin(t *testing.T) {
+	s, store := newTestServer(t)
+
+	acc, err := model.NewAccount("Jane", "Doe", "s3cret")
+	if err != nil {
+		t.Fatalf("NewAccount returned error: %v", err)
+	}
+	if err := store.CreateAccount(acc); err != nil {
+		t.Fatalf("CreateAccount returned error: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		password   string
+		wantStatus int
+	}{
+		{name: "correct password", password: "s3cret", wantStatus: http.StatusOK},
+		{name: "wrong password", password: "wrong", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(model.LoginRequest{Number: acc.Number, Password: tt.password})
+			req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body))
+			rec := httptest.NewRecorder()
+
+			makeHTTPHandleFunc(s.HandleLogin)(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus == http.StatusOK {
+				var resp model.LoginReponse
+				if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if resp.AccessToken == "" || resp.RefreshToken == "" {
+					t.Errorf("response missing tokens: %+v", resp)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleTransferToAccount(t *testing.T) {
+	s, store := newTestServer(t)
+
+	from, _ := model.NewAccount("Jane", "Doe", "s3cret")
+	from.Balance = 100
+	store.CreateAccount(from)
+
+	to, _ := model.NewAccount("John", "Roe", "s3cret")
+	store.CreateAccount(to)
+
+	access, _, err := auth.IssueTokenPair(from.Number, from.Role)
+	if err != nil {
+		t.Fatalf("IssueTokenPair returned error: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		toAccount  int
+		amount     int64
+		wantStatus int
+	}{
+		{name: "valid transfer", toAccount: to.ID, amount: 40, wantStatus: http.StatusOK},
+		{name: "self transfer rejected", toAccount: from.ID, amount: 10, wantStatus: http.StatusBadRequest},
+		{name: "non-positive amount rejected", toAccount: to.ID, amount: 0, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(model.TransferRequest{ToAccount: tt.toAccount, Amount: tt.amount})
+			req := httptest.NewRequest(http.MethodPost, "/transfer", bytes.NewReader(body))
+			req.Header.Set("x-jwt-token", access)
+			rec := httptest.NewRecorder()
+
+			withJWTAuth(makeHTTPHandleFunc(s.HandleTransferToAccount), store)(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestWithJWTAuthOwnership(t *testing.T) {
+	s, store := newTestServer(t)
+
+	owner, _ := model.NewAccount("Jane", "Doe", "s3cret")
+	store.CreateAccount(owner)
+	other, _ := model.NewAccount("John", "Roe", "s3cret")
+	store.CreateAccount(other)
+
+	access, _, err := auth.IssueTokenPair(owner.Number, owner.Role)
+	if err != nil {
+		t.Fatalf("IssueTokenPair returned error: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		accountID  int
+		wantStatus int
+	}{
+		{name: "owner can access own account", accountID: owner.ID, wantStatus: http.StatusOK},
+		{name: "owner cannot access other account", accountID: other.ID, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := chi.NewMux()
+			router.Get("/account/{id}", withJWTAuth(makeHTTPHandleFunc(s.HandleGetAccountByID), store))
+
+			req := httptest.NewRequest(http.MethodGet, "/account/"+strconv.Itoa(tt.accountID), nil)
+			req.Header.Set("x-jwt-token", access)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Flamme97/bankGO/pkg/auth"
+	"github.com/Flamme97/bankGO/pkg/model"
+)
+
+func (s *APIServer) HandleTransferToAccount(w http.ResponseWriter, r *http.Request) error {
+	claims, ok := auth.ClaimsFromContext(r)
+	if !ok {
+		PermissionsDenied(w)
+		return nil
+	}
+	number, ok := claims["accountNumber"].(float64)
+	if !ok {
+		PermissionsDenied(w)
+		return nil
+	}
+	from, err := s.store.GetAccountByNumber(int(number))
+	if err != nil {
+		return err
+	}
+
+	transferReq := new(model.TransferRequest)
+	if err := json.NewDecoder(r.Body).Decode(transferReq); err != nil {
+		return err
+	}
+	defer r.Body.Close()
+
+	if transferReq.Amount <= 0 {
+		return fmt.Errorf("transfer amount must be positive")
+	}
+	if transferReq.ToAccount == from.ID {
+		return fmt.Errorf("cannot transfer to your own account")
+	}
+
+	transfer, err := s.store.Transfer(r.Context(), from.ID, transferReq.ToAccount, transferReq.Amount)
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, transfer)
+}
+
+// HandleGetLedger returns a page of an account's ledger entries, most
+// recent first. Both limit and offset are optional query parameters.
+func (s *APIServer) HandleGetLedger(w http.ResponseWriter, r *http.Request) error {
+	id, err := getID(r)
+	if err != nil {
+		return err
+	}
+
+	limit := 50
+	if v := r.URL.Query().Get("limit"); v != "" {
+		if parsed, err := strconv.Atoi(v); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+	offset := 0
+	if v := r.URL.Query().Get("offset"); v != "" {
+		if parsed, err := strconv.Atoi(v); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	entries, err := s.store.GetLedger(id, limit, offset)
+	if err != nil {
+		return err
+	}
+	return WriteJSON(w, http.StatusOK, entries)
+}
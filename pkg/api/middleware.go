@@ -0,0 +1,58 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/Flamme97/bankGO/pkg/auth"
+	"github.com/Flamme97/bankGO/pkg/model"
+	"github.com/Flamme97/bankGO/pkg/storage"
+)
+
+// withJWTAuth authenticates the request via pkg/auth and additionally
+// enforces that the caller owns the {id} in the URL, unless the caller
+// is an admin.
+func withJWTAuth(next http.HandlerFunc, s storage.Storage) http.HandlerFunc {
+	return auth.RequireAuth(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := auth.ClaimsFromContext(r)
+		if !ok {
+			PermissionsDenied(w)
+			return
+		}
+		isAdmin := claims["role"] == model.RoleAdmin
+
+		if idStr := chi.URLParam(r, "id"); idStr != "" && !isAdmin {
+			userID, err := getID(r)
+			if err != nil {
+				PermissionsDenied(w)
+				return
+			}
+			account, err := s.GetAccountByID(userID)
+			if err != nil {
+				PermissionsDenied(w)
+				return
+			}
+			if account.Number != int64(claims["accountNumber"].(float64)) {
+				PermissionsDenied(w)
+				return
+			}
+		}
+
+		next(w, r)
+	}, s.IsTokenRevoked)
+}
+
+// requireRole builds a route decorator that authenticates the caller and
+// rejects any token whose role claim doesn't match role.
+func requireRole(role string, s storage.Storage) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return withJWTAuth(func(w http.ResponseWriter, r *http.Request) {
+			auth.RequireRole(role, next)(w, r)
+		}, s)
+	}
+}
+
+func PermissionsDenied(w http.ResponseWriter) {
+	WriteJSON(w, http.StatusForbidden, APIError{Error: "Permission denied"})
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/Flamme97/bankGO/pkg/auth"
+	"github.com/Flamme97/bankGO/pkg/model"
+)
+
+func TestRequireRoleRejectsNonAdmin(t *testing.T) {
+	s, store := newTestServer(t)
+
+	user, _ := model.NewAccount("Jane", "Doe", "s3cret")
+	store.CreateAccount(user)
+
+	access, _, err := auth.IssueTokenPair(user.Number, user.Role)
+	if err != nil {
+		t.Fatalf("IssueTokenPair returned error: %v", err)
+	}
+
+	router := chi.NewMux()
+	router.Get("/accounts", requireRole(model.RoleAdmin, store)(makeHTTPHandleFunc(s.HandleGetAccount)))
+	router.Patch("/account/{id}/role", requireRole(model.RoleAdmin, store)(makeHTTPHandleFunc(s.HandleUpdateRole)))
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+	}{
+		{name: "list accounts", method: http.MethodGet, path: "/accounts"},
+		{name: "update role", method: http.MethodPatch, path: "/account/" + strconv.Itoa(user.ID) + "/role"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("x-jwt-token", access)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusForbidden {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, http.StatusForbidden, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestWithJWTAuthAdminBypassesOwnership(t *testing.T) {
+	s, store := newTestServer(t)
+
+	admin, _ := model.NewAccountWithRole("Ann", "Admin", "s3cret", model.RoleAdmin)
+	store.CreateAccount(admin)
+	other, _ := model.NewAccount("Jane", "Doe", "s3cret")
+	store.CreateAccount(other)
+
+	access, _, err := auth.IssueTokenPair(admin.Number, admin.Role)
+	if err != nil {
+		t.Fatalf("IssueTokenPair returned error: %v", err)
+	}
+
+	router := chi.NewMux()
+	router.Get("/account/{id}", withJWTAuth(makeHTTPHandleFunc(s.HandleGetAccountByID), store))
+	router.Delete("/account/{id}", withJWTAuth(makeHTTPHandleFunc(s.HandleDeleteAccount), store))
+
+	t.Run("admin can read another account", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/account/"+strconv.Itoa(other.ID), nil)
+		req.Header.Set("x-jwt-token", access)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d (body: %s)", rec.Code, http.StatusOK, rec.Body.String())
+		}
+	})
+
+	t.Run("admin can delete another account", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, "/account/"+strconv.Itoa(other.ID), nil)
+		req.Header.Set("x-jwt-token", access)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d (body: %s)", rec.Code, http.StatusOK, rec.Body.String())
+		}
+	})
+}
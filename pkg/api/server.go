@@ -0,0 +1,92 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/Flamme97/bankGO/pkg/mail"
+	"github.com/Flamme97/bankGO/pkg/model"
+	"github.com/Flamme97/bankGO/pkg/storage"
+)
+
+// resetRateLimitMax and resetRateLimitWindow cap how many password-reset
+// requests a single account number or client IP can make per window.
+const (
+	resetRateLimitMax    = 5
+	resetRateLimitWindow = time.Hour
+)
+
+type APIServer struct {
+	listenAddr   string
+	store        storage.Storage
+	mailer       mail.Mailer
+	resetLimiter *rateLimiter
+}
+
+type apiFunc func(http.ResponseWriter, *http.Request) error
+
+type APIError struct {
+	Error string `json:"error"`
+}
+
+func NewAPIServer(listenAddr string, store storage.Storage, mailer mail.Mailer) *APIServer {
+	return &APIServer{
+		listenAddr:   listenAddr,
+		store:        store,
+		mailer:       mailer,
+		resetLimiter: newRateLimiter(resetRateLimitMax, resetRateLimitWindow),
+	}
+}
+
+func (s *APIServer) Run() {
+	router := chi.NewMux()
+
+	router.HandleFunc("/login", makeHTTPHandleFunc(s.HandleLogin))
+	router.Post("/auth/refresh", makeHTTPHandleFunc(s.HandleRefresh))
+	router.Post("/auth/logout", withJWTAuth(makeHTTPHandleFunc(s.HandleLogout), s.store))
+
+	router.Post("/account", makeHTTPHandleFunc(s.HandleCreateAccount))
+	router.Get("/accounts", requireRole(model.RoleAdmin, s.store)(makeHTTPHandleFunc(s.HandleGetAccount)))
+
+	router.Get("/account/{id}", withJWTAuth(makeHTTPHandleFunc(s.HandleGetAccountByID), s.store))
+	router.Delete("/account/{id}", withJWTAuth(makeHTTPHandleFunc(s.HandleDeleteAccount), s.store))
+	router.Patch("/account/{id}/role", requireRole(model.RoleAdmin, s.store)(makeHTTPHandleFunc(s.HandleUpdateRole)))
+	router.Get("/account/{id}/ledger", withJWTAuth(makeHTTPHandleFunc(s.HandleGetLedger), s.store))
+
+	router.Post("/transfer", withJWTAuth(makeHTTPHandleFunc(s.HandleTransferToAccount), s.store))
+
+	router.Post("/auth/password-reset/request", makeHTTPHandleFunc(s.HandlePasswordResetRequest))
+	router.Post("/auth/password-reset/confirm", makeHTTPHandleFunc(s.HandlePasswordResetConfirm))
+
+	log.Println("Server API running on port", s.listenAddr)
+	http.ListenAndServe(s.listenAddr, router)
+}
+
+func WriteJSON(w http.ResponseWriter, status int, v any) error {
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(v)
+}
+
+func makeHTTPHandleFunc(f apiFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := f(w, r); err != nil {
+			WriteJSON(w, http.StatusBadRequest, APIError{Error: err.Error()})
+		}
+	}
+}
+
+func getID(r *http.Request) (int, error) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return id, fmt.Errorf("invalid ID provided %v", idStr)
+	}
+	return id, nil
+}
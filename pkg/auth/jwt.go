@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+const (
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 7 * 24 * time.Hour
+
+	TypeAccess  = "access"
+	TypeRefresh = "refresh"
+)
+
+// IssueTokenPair creates a fresh access/refresh token pair for the given
+// account number and role, each carrying its own jti so either half can
+// be revoked independently.
+func IssueTokenPair(number int64, role string) (access, refresh string, err error) {
+	access, err = createToken(number, role, TypeAccess, AccessTokenTTL)
+	if err != nil {
+		return "", "", err
+	}
+	refresh, err = createToken(number, role, TypeRefresh, RefreshTokenTTL)
+	if err != nil {
+		return "", "", err
+	}
+	return access, refresh, nil
+}
+
+func createToken(number int64, role, typ string, ttl time.Duration) (string, error) {
+	secret, err := Secret()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims := &jwt.MapClaims{
+		"jti":           uuid.NewString(),
+		"typ":           typ,
+		"sub":           number,
+		"accountNumber": number,
+		"role":          role,
+		"iat":           now.Unix(),
+		"exp":           now.Add(ttl).Unix(),
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	return token.SignedString(secret)
+}
+
+func ValidateJWT(tokenStr string) (*jwt.Token, error) {
+	secret, err := Secret()
+	if err != nil {
+		return nil, err
+	}
+	return jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
+
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+
+		return secret, nil
+	})
+}
+
+// Secret returns the HMAC signing key from JWT_SECRET, failing closed
+// when it isn't configured rather than signing with an empty key.
+func Secret() ([]byte, error) {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		return nil, fmt.Errorf("JWT_SECRET is not set")
+	}
+	return []byte(secret), nil
+}
+
+// ExpiresAt reads the exp claim back out as a time.Time, defaulting to
+// now+RefreshTokenTTL if the claim is missing or malformed.
+func ExpiresAt(claims jwt.MapClaims) time.Time {
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return time.Now().Add(RefreshTokenTTL)
+	}
+	return time.Unix(int64(exp), 0)
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"os"
+	"testing"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+func TestIssueTokenPairRoundTrip(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+
+	access, refresh, err := IssueTokenPair(42, RoleForTest)
+	if err != nil {
+		t.Fatalf("IssueTokenPair returned error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantTyp string
+	}{
+		{name: "access token", token: access, wantTyp: TypeAccess},
+		{name: "refresh token", token: refresh, wantTyp: TypeRefresh},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := ValidateJWT(tt.token)
+			if err != nil || !parsed.Valid {
+				t.Fatalf("ValidateJWT() error = %v, valid = %v", err, parsed.Valid)
+			}
+			claims := parsed.Claims.(jwt.MapClaims)
+			if claims["typ"] != tt.wantTyp {
+				t.Errorf("typ claim = %v, want %v", claims["typ"], tt.wantTyp)
+			}
+			if claims["jti"] == "" || claims["jti"] == nil {
+				t.Errorf("jti claim missing")
+			}
+		})
+	}
+}
+
+func TestIssueTokenPairFailsClosedWithoutSecret(t *testing.T) {
+	os.Unsetenv("JWT_SECRET")
+
+	if _, _, err := IssueTokenPair(42, RoleForTest); err == nil {
+		t.Fatalf("IssueTokenPair with empty JWT_SECRET: got nil error, want error")
+	}
+}
+
+const RoleForTest = "user"
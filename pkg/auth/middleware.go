@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+type contextKey string
+
+const claimsContextKey contextKey = "claims"
+
+// RevocationChecker reports whether a jti has been revoked. Callers pass
+// a Storage method value here so this package never needs to depend on
+// the concrete storage implementation.
+type RevocationChecker func(jti string) (bool, error)
+
+// RequireAuth validates the x-jwt-token header, rejects revoked or
+// malformed tokens, and stores the parsed claims on the request context
+// for downstream handlers.
+func RequireAuth(next http.HandlerFunc, isRevoked RevocationChecker) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tokenString := r.Header.Get("x-jwt-token")
+		if tokenString == "" {
+			Deny(w)
+			return
+		}
+
+		token, err := ValidateJWT(tokenString)
+		if err != nil || !token.Valid {
+			Deny(w)
+			return
+		}
+
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok || claims["typ"] != TypeAccess {
+			Deny(w)
+			return
+		}
+
+		jti, _ := claims["jti"].(string)
+		revoked, err := isRevoked(jti)
+		if err != nil || revoked {
+			Deny(w)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), claimsContextKey, claims)
+		next(w, r.WithContext(ctx))
+	}
+}
+
+// RequireRole further restricts an already-authenticated request to
+// callers whose role claim matches role.
+func RequireRole(role string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := ClaimsFromContext(r)
+		if !ok || claims["role"] != role {
+			Deny(w)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func ClaimsFromContext(r *http.Request) (jwt.MapClaims, bool) {
+	claims, ok := r.Context().Value(claimsContextKey).(jwt.MapClaims)
+	return claims, ok
+}
+
+func Deny(w http.ResponseWriter) {
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	json.NewEncoder(w).Encode(map[string]string{"error": "Permission denied"})
+}
@@ -0,0 +1,30 @@
+package auth
+
+import "testing"
+
+func TestHashAndComparePassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		compare  string
+		want     bool
+	}{
+		{name: "matching password", password: "s3cret", compare: "s3cret", want: true},
+		{name: "mismatched password", password: "s3cret", compare: "wrong", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := HashPassword(tt.password)
+			if err != nil {
+				t.Fatalf("HashPassword returned error: %v", err)
+			}
+			if hash == tt.password {
+				t.Fatalf("HashPassword did not hash the input")
+			}
+			if got := ComparePassword(hash, tt.compare); got != tt.want {
+				t.Errorf("ComparePassword() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
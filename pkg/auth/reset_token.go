@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// GenerateResetToken returns a cryptographically random, base64url
+// encoded 32-byte token to email to the user, plus its SHA-256 hash for
+// storage. Only the hash is persisted.
+//
+// NOTE: this deliberately deviates from a literal "bcrypt hash" of the
+// token: the confirm step looks the token up by its hash, which needs
+// a deterministic digest, and bcrypt salts per-call so it can't be used
+// for an equality lookup without hashing every outstanding reset row.
+// SHA-256 is safe here because the token itself carries 256 bits of
+// random entropy, unlike a user password. Flagging this explicitly
+// since it departs from the original request's wording.
+func GenerateResetToken() (token, hash string, err error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+	token = base64.URLEncoding.EncodeToString(raw)
+	return token, HashResetToken(token), nil
+}
+
+func HashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
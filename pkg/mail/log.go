@@ -0,0 +1,13 @@
+package mail
+
+import "log"
+
+// LogMailer logs emails instead of sending them. Handy as the default
+// Mailer in local development so password resets work without an SMTP
+// relay configured.
+type LogMailer struct{}
+
+func (LogMailer) Send(to, subject, body string) error {
+	log.Printf("mail to=%s subject=%q body=%q", to, subject, body)
+	return nil
+}
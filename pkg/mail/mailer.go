@@ -0,0 +1,8 @@
+package mail
+
+// Mailer sends a single plain-text email. Implementations are swapped
+// via APIServer construction so tests and local dev don't need a real
+// SMTP server.
+type Mailer interface {
+	Send(to, subject, body string) error
+}
@@ -0,0 +1,26 @@
+package mail
+
+import (
+	"fmt"
+	"net/smtp"
+)
+
+// SMTPMailer sends mail through a standard SMTP relay using PLAIN auth.
+type SMTPMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTPMailer(host, port, username, password, from string) *SMTPMailer {
+	return &SMTPMailer{
+		addr: host + ":" + port,
+		from: from,
+		auth: smtp.PlainAuth("", username, password, host),
+	}
+}
+
+func (m *SMTPMailer) Send(to, subject, body string) error {
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s", m.from, to, subject, body)
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{to}, []byte(msg))
+}
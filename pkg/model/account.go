@@ -0,0 +1,55 @@
+package model
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/Flamme97/bankGO/pkg/auth"
+)
+
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+type Account struct {
+	ID                int       `json:"id" db:"id"`
+	FirstName         string    `json:"firstName" db:"firstname"`
+	LastName          string    `json:"lastName" db:"lastname"`
+	Number            int64     `json:"number" db:"number"`
+	Balance           int64     `json:"balance" db:"balance"`
+	Role              string    `json:"role" db:"role"`
+	Email             string    `json:"email" db:"email"`
+	CreatedAt         time.Time `json:"createdat" db:"createdat"`
+	EncryptedPassword string    `json:"-" db:"encrypted_password"`
+}
+
+func (a *Account) ValidatePW(pw string) bool {
+	return auth.ComparePassword(a.EncryptedPassword, pw)
+}
+
+func (a *Account) IsAdmin() bool {
+	return a.Role == RoleAdmin
+}
+
+func NewAccount(firstname, lastname, password string) (*Account, error) {
+	return NewAccountWithRole(firstname, lastname, password, RoleUser)
+}
+
+func NewAccountWithRole(firstname, lastname, password, role string) (*Account, error) {
+	encpw, err := auth.HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	if role == "" {
+		role = RoleUser
+	}
+	return &Account{
+		FirstName:         firstname,
+		LastName:          lastname,
+		Number:            int64(rand.Intn(100000)),
+		Role:              role,
+		EncryptedPassword: encpw,
+		CreatedAt:         time.Now().UTC(),
+	}, nil
+}
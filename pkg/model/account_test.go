@@ -0,0 +1,65 @@
+package model
+
+import "testing"
+
+func TestNewAccountWithRole(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     string
+		wantRole string
+	}{
+		{name: "defaults to user role", role: "", wantRole: RoleUser},
+		{name: "keeps admin role", role: RoleAdmin, wantRole: RoleAdmin},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acc, err := NewAccountWithRole("Jane", "Doe", "s3cret", tt.role)
+			if err != nil {
+				t.Fatalf("NewAccountWithRole returned error: %v", err)
+			}
+			if acc.Role != tt.wantRole {
+				t.Errorf("Role = %q, want %q", acc.Role, tt.wantRole)
+			}
+			if acc.EncryptedPassword == "s3cret" {
+				t.Errorf("password was not hashed")
+			}
+		})
+	}
+}
+
+func TestAccountValidatePW(t *testing.T) {
+	acc, err := NewAccount("Jane", "Doe", "s3cret")
+	if err != nil {
+		t.Fatalf("NewAccount returned error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		pw   string
+		want bool
+	}{
+		{name: "correct password", pw: "s3cret", want: true},
+		{name: "wrong password", pw: "wrong", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := acc.ValidatePW(tt.pw); got != tt.want {
+				t.Errorf("ValidatePW(%q) = %v, want %v", tt.pw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccountIsAdmin(t *testing.T) {
+	admin, _ := NewAccountWithRole("A", "B", "pw", RoleAdmin)
+	user, _ := NewAccountWithRole("A", "B", "pw", RoleUser)
+
+	if !admin.IsAdmin() {
+		t.Errorf("admin account: IsAdmin() = false, want true")
+	}
+	if user.IsAdmin() {
+		t.Errorf("user account: IsAdmin() = true, want false")
+	}
+}
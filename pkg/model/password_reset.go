@@ -0,0 +1,15 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+type PasswordReset struct {
+	ID         int          `db:"id"`
+	AccountID  int          `db:"account_id"`
+	TokenHash  string       `db:"token_hash"`
+	ExpiresAt  time.Time    `db:"expires_at"`
+	ConsumedAt sql.NullTime `db:"consumed_at"`
+	CreatedAt  time.Time    `db:"created_at"`
+}
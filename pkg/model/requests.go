@@ -0,0 +1,45 @@
+package model
+
+type CreateAccountRequest struct {
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email"`
+	Password  string `json:"password"`
+}
+
+type LoginRequest struct {
+	Number   int64  `json:"number"`
+	Password string `json:"password"`
+}
+
+type LoginReponse struct {
+	Number       int64  `json:"number"`
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+type TransferRequest struct {
+	ToAccount int   `json:"toAccount"`
+	Amount    int64 `json:"amount"`
+}
+
+type UpdateRoleRequest struct {
+	Role string `json:"role"`
+}
+
+type PasswordResetRequest struct {
+	Number int64 `json:"number"`
+}
+
+type PasswordResetConfirmRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"newPassword"`
+}
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+type LedgerEntryType string
+
+const (
+	LedgerDebit  LedgerEntryType = "debit"
+	LedgerCredit LedgerEntryType = "credit"
+)
+
+type Transfer struct {
+	TxID      string    `json:"txId" db:"tx_id"`
+	FromID    int       `json:"fromId" db:"from_id"`
+	ToID      int       `json:"toId" db:"to_id"`
+	Amount    int64     `json:"amount" db:"amount"`
+	Status    string    `json:"status" db:"status"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+}
+
+type LedgerEntry struct {
+	ID             int             `json:"id" db:"id"`
+	AccountID      int             `json:"accountId" db:"account_id"`
+	CounterpartyID int             `json:"counterpartyId" db:"counterparty_id"`
+	Amount         int64           `json:"amount" db:"amount"`
+	Type           LedgerEntryType `json:"type" db:"type"`
+	TxID           string          `json:"txId" db:"tx_id"`
+	BalanceAfter   int64           `json:"balanceAfter" db:"balance_after"`
+	CreatedAt      time.Time       `json:"createdAt" db:"created_at"`
+}
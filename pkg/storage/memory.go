@@ -0,0 +1,227 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Flamme97/bankGO/pkg/model"
+)
+
+// MemoryStore is an in-memory Storage implementation used by unit tests
+// that don't need a real Postgres instance.
+type MemoryStore struct {
+	mu             sync.Mutex
+	nextID         int
+	nextResetID    int
+	accounts       map[int]*model.Account
+	revoked        map[string]time.Time
+	ledger         []*model.LedgerEntry
+	passwordResets map[int]*model.PasswordReset
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		nextID:         1,
+		nextResetID:    1,
+		accounts:       make(map[int]*model.Account),
+		revoked:        make(map[string]time.Time),
+		passwordResets: make(map[int]*model.PasswordReset),
+	}
+}
+
+func (s *MemoryStore) CreateAccount(a *model.Account) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a.ID = s.nextID
+	s.nextID++
+	s.accounts[a.ID] = a
+	return nil
+}
+
+func (s *MemoryStore) DeleteAccount(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.accounts, id)
+	return nil
+}
+
+func (s *MemoryStore) UpdateAccount(*model.Account) error {
+	return nil
+}
+
+func (s *MemoryStore) GetAccounts() ([]*model.Account, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	accounts := make([]*model.Account, 0, len(s.accounts))
+	for _, acc := range s.accounts {
+		accounts = append(accounts, acc)
+	}
+	return accounts, nil
+}
+
+func (s *MemoryStore) GetAccountByID(id int) (*model.Account, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc, ok := s.accounts[id]
+	if !ok {
+		return nil, fmt.Errorf("Account %d not found", id)
+	}
+	return acc, nil
+}
+
+func (s *MemoryStore) GetAccountByNumber(number int) (*model.Account, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, acc := range s.accounts {
+		if acc.Number == int64(number) {
+			return acc, nil
+		}
+	}
+	return nil, fmt.Errorf("Account with number %d not found", number)
+}
+
+func (s *MemoryStore) UpdateAccountRole(id int, role string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc, ok := s.accounts[id]
+	if !ok {
+		return fmt.Errorf("Account %d not found", id)
+	}
+	acc.Role = role
+	return nil
+}
+
+func (s *MemoryStore) RevokeToken(jti string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.revoked[jti] = expiresAt
+	return nil
+}
+
+func (s *MemoryStore) IsTokenRevoked(jti string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.revoked[jti]
+	return ok, nil
+}
+
+func (s *MemoryStore) Transfer(ctx context.Context, fromID, toID int, amount int64) (*model.Transfer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	from, ok := s.accounts[fromID]
+	if !ok {
+		return nil, fmt.Errorf("Account %d not found", fromID)
+	}
+	to, ok := s.accounts[toID]
+	if !ok {
+		return nil, fmt.Errorf("Account %d not found", toID)
+	}
+	if from.Balance < amount {
+		return nil, fmt.Errorf("insufficient balance in account %d", fromID)
+	}
+
+	from.Balance -= amount
+	to.Balance += amount
+
+	txID := fmt.Sprintf("mem-%d", len(s.ledger)/2+1)
+	now := time.Now().UTC()
+
+	s.ledger = append(s.ledger,
+		&model.LedgerEntry{ID: len(s.ledger) + 1, AccountID: fromID, CounterpartyID: toID, Amount: amount, Type: model.LedgerDebit, TxID: txID, BalanceAfter: from.Balance, CreatedAt: now},
+		&model.LedgerEntry{ID: len(s.ledger) + 2, AccountID: toID, CounterpartyID: fromID, Amount: amount, Type: model.LedgerCredit, TxID: txID, BalanceAfter: to.Balance, CreatedAt: now},
+	)
+
+	return &model.Transfer{
+		TxID:      txID,
+		FromID:    fromID,
+		ToID:      toID,
+		Amount:    amount,
+		Status:    "completed",
+		CreatedAt: now,
+	}, nil
+}
+
+func (s *MemoryStore) GetLedger(accountID, limit, offset int) ([]*model.LedgerEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	matches := []*model.LedgerEntry{}
+	for i := len(s.ledger) - 1; i >= 0; i-- {
+		if s.ledger[i].AccountID == accountID {
+			matches = append(matches, s.ledger[i])
+		}
+	}
+
+	if offset >= len(matches) {
+		return []*model.LedgerEntry{}, nil
+	}
+	end := offset + limit
+	if end > len(matches) {
+		end = len(matches)
+	}
+	return matches[offset:end], nil
+}
+
+func (s *MemoryStore) UpdatePasswordHash(id int, hash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc, ok := s.accounts[id]
+	if !ok {
+		return fmt.Errorf("Account %d not found", id)
+	}
+	acc.EncryptedPassword = hash
+	return nil
+}
+
+func (s *MemoryStore) CreatePasswordReset(accountID int, tokenHash string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reset := &model.PasswordReset{
+		ID:        s.nextResetID,
+		AccountID: accountID,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now().UTC(),
+	}
+	s.passwordResets[reset.ID] = reset
+	s.nextResetID++
+	return nil
+}
+
+func (s *MemoryStore) GetPasswordResetByTokenHash(tokenHash string) (*model.PasswordReset, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, reset := range s.passwordResets {
+		if reset.TokenHash == tokenHash {
+			return reset, nil
+		}
+	}
+	return nil, fmt.Errorf("password reset token not found")
+}
+
+func (s *MemoryStore) ConsumePasswordReset(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reset, ok := s.passwordResets[id]
+	if !ok {
+		return fmt.Errorf("password reset %d not found", id)
+	}
+	reset.ConsumedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+	return nil
+}
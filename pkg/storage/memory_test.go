@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Flamme97/bankGO/pkg/model"
+)
+
+func newTestAccount(t *testing.T, s *MemoryStore, balance int64) *model.Account {
+	t.Helper()
+	acc, err := model.NewAccount("Jane", "Doe", "s3cret")
+	if err != nil {
+		t.Fatalf("NewAccount returned error: %v", err)
+	}
+	acc.Balance = balance
+	if err := s.CreateAccount(acc); err != nil {
+		t.Fatalf("CreateAccount returned error: %v", err)
+	}
+	return acc
+}
+
+func TestMemoryStoreTransfer(t *testing.T) {
+	tests := []struct {
+		name        string
+		fromBalance int64
+		amount      int64
+		wantErr     bool
+	}{
+		{name: "sufficient balance", fromBalance: 100, amount: 40, wantErr: false},
+		{name: "insufficient balance", fromBalance: 10, amount: 40, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMemoryStore()
+			from := newTestAccount(t, s, tt.fromBalance)
+			to := newTestAccount(t, s, 0)
+
+			transfer, err := s.Transfer(context.Background(), from.ID, to.ID, tt.amount)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Transfer(): got nil error, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transfer() returned error: %v", err)
+			}
+
+			gotFrom, _ := s.GetAccountByID(from.ID)
+			gotTo, _ := s.GetAccountByID(to.ID)
+			if gotFrom.Balance != tt.fromBalance-tt.amount {
+				t.Errorf("from.Balance = %d, want %d", gotFrom.Balance, tt.fromBalance-tt.amount)
+			}
+			if gotTo.Balance != tt.amount {
+				t.Errorf("to.Balance = %d, want %d", gotTo.Balance, tt.amount)
+			}
+
+			ledger, err := s.GetLedger(from.ID, 10, 0)
+			if err != nil {
+				t.Fatalf("GetLedger() returned error: %v", err)
+			}
+			if len(ledger) != 1 || ledger[0].TxID != transfer.TxID {
+				t.Errorf("GetLedger() = %+v, want one entry for tx %s", ledger, transfer.TxID)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreRevocation(t *testing.T) {
+	s := NewMemoryStore()
+
+	revoked, err := s.IsTokenRevoked("some-jti")
+	if err != nil {
+		t.Fatalf("IsTokenRevoked() returned error: %v", err)
+	}
+	if revoked {
+		t.Fatalf("IsTokenRevoked() = true before RevokeToken, want false")
+	}
+
+	if err := s.RevokeToken("some-jti", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("RevokeToken() returned error: %v", err)
+	}
+
+	revoked, err = s.IsTokenRevoked("some-jti")
+	if err != nil {
+		t.Fatalf("IsTokenRevoked() returned error: %v", err)
+	}
+	if !revoked {
+		t.Fatalf("IsTokenRevoked() = false after RevokeToken, want true")
+	}
+}
@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+)
+
+const defaultMigrationsPath = "file://db/migrations"
+
+// migrationsSource returns the migrate source URL for db/migrations,
+// defaulting to a path relative to the process's working directory
+// (cmd/bankgo is expected to run from the repo root) but overridable via
+// MIGRATIONS_PATH so tests can point at it from any package directory.
+func migrationsSource() string {
+	if v := os.Getenv("MIGRATIONS_PATH"); v != "" {
+		return v
+	}
+	return defaultMigrationsPath
+}
+
+// runMigrations applies every pending migration against connStr, making
+// schema changes (adding columns, fixing a column's type, creating a
+// new table) an ordered, reviewable, reversible history instead of
+// ad-hoc CREATE TABLE IF NOT EXISTS statements.
+func runMigrations(connStr string) error {
+	m, err := migrate.New(migrationsSource(), connStr)
+	if err != nil {
+		return fmt.Errorf("open migrations: %w", err)
+	}
+	defer m.Close()
+
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		return fmt.Errorf("run migrations: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Flamme97/bankGO/pkg/model"
+)
+
+type PostgresStore struct {
+	db *sqlx.DB
+}
+
+func NewPostgresStore() (*PostgresStore, error) {
+
+	connStr := os.Getenv("DB_URL")
+	db, err := sqlx.Open("postgres", connStr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+	configureConnPool(db)
+
+	return &PostgresStore{
+		db: db,
+	}, nil
+}
+
+// configureConnPool sizes the pool from env vars so it can be tuned per
+// deployment without a code change.
+func configureConnPool(db *sqlx.DB) {
+	db.SetMaxOpenConns(envInt("DB_MAX_OPEN_CONNS", 25))
+	db.SetMaxIdleConns(envInt("DB_MAX_IDLE_CONNS", 25))
+	db.SetConnMaxLifetime(time.Duration(envInt("DB_CONN_MAX_LIFETIME_MINUTES", 5)) * time.Minute)
+}
+
+func envInt(key string, fallback int) int {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return fallback
+	}
+	return n
+}
+
+func (s *PostgresStore) Init() error {
+	return runMigrations(os.Getenv("DB_URL"))
+}
+
+func (s *PostgresStore) RevokeToken(jti string, expiresAt time.Time) error {
+	_, err := s.db.Exec(
+		`INSERT INTO revoked_tokens (jti, expires_at) VALUES ($1, $2) ON CONFLICT (jti) DO NOTHING`,
+		jti, expiresAt)
+	return err
+}
+
+func (s *PostgresStore) IsTokenRevoked(jti string) (bool, error) {
+	var exists bool
+	err := s.db.Get(&exists, `SELECT EXISTS(SELECT 1 FROM revoked_tokens WHERE jti = $1)`, jti)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// Transfer moves amount from fromID to toID inside a single transaction,
+// locking both account rows in ascending id order to avoid deadlocking
+// against a concurrent transfer running in the opposite direction.
+func (s *PostgresStore) Transfer(ctx context.Context, fromID, toID int, amount int64) (*model.Transfer, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	firstID, secondID := fromID, toID
+	if firstID > secondID {
+		firstID, secondID = secondID, firstID
+	}
+
+	var firstBalance, secondBalance int64
+	if err := tx.GetContext(ctx, &firstBalance, `SELECT balance FROM account WHERE id = $1 FOR UPDATE`, firstID); err != nil {
+		return nil, err
+	}
+	if err := tx.GetContext(ctx, &secondBalance, `SELECT balance FROM account WHERE id = $1 FOR UPDATE`, secondID); err != nil {
+		return nil, err
+	}
+
+	balances := map[int]int64{firstID: firstBalance, secondID: secondBalance}
+	if balances[fromID] < amount {
+		return nil, fmt.Errorf("insufficient balance in account %d", fromID)
+	}
+
+	fromBalanceAfter := balances[fromID] - amount
+	toBalanceAfter := balances[toID] + amount
+
+	if _, err := tx.ExecContext(ctx, `UPDATE account SET balance = $1 WHERE id = $2`, fromBalanceAfter, fromID); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE account SET balance = $1 WHERE id = $2`, toBalanceAfter, toID); err != nil {
+		return nil, err
+	}
+
+	txID := uuid.NewString()
+	transfer := &model.Transfer{
+		TxID:      txID,
+		FromID:    fromID,
+		ToID:      toID,
+		Amount:    amount,
+		Status:    "completed",
+		CreatedAt: time.Now().UTC(),
+	}
+	if _, err := tx.NamedExecContext(ctx,
+		`INSERT INTO transfers (tx_id, from_id, to_id, amount, status, created_at)
+		VALUES (:tx_id, :from_id, :to_id, :amount, :status, :created_at)`,
+		transfer); err != nil {
+		return nil, err
+	}
+
+	debit := &model.LedgerEntry{AccountID: fromID, CounterpartyID: toID, Amount: amount, Type: model.LedgerDebit, TxID: txID, BalanceAfter: fromBalanceAfter, CreatedAt: transfer.CreatedAt}
+	credit := &model.LedgerEntry{AccountID: toID, CounterpartyID: fromID, Amount: amount, Type: model.LedgerCredit, TxID: txID, BalanceAfter: toBalanceAfter, CreatedAt: transfer.CreatedAt}
+	for _, entry := range []*model.LedgerEntry{debit, credit} {
+		if _, err := tx.NamedExecContext(ctx,
+			`INSERT INTO ledger_entries (account_id, counterparty_id, amount, type, tx_id, balance_after, created_at)
+			VALUES (:account_id, :counterparty_id, :amount, :type, :tx_id, :balance_after, :created_at)`,
+			entry); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+func (s *PostgresStore) GetLedger(accountID, limit, offset int) ([]*model.LedgerEntry, error) {
+	entries := []*model.LedgerEntry{}
+	err := s.db.Select(&entries,
+		`SELECT id, account_id, counterparty_id, amount, type, tx_id, balance_after, created_at
+		FROM ledger_entries WHERE account_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3`,
+		accountID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (s *PostgresStore) CreateAccount(a *model.Account) error {
+	query := `
+	INSERT INTO account (firstname, lastname, number, balance, role, email, createdat, encrypted_password)
+	VALUES (:firstname, :lastname, :number, :balance, :role, :email, :createdat, :encrypted_password)
+	`
+	_, err := s.db.NamedExec(query, a)
+	return err
+}
+
+func (s *PostgresStore) UpdateAccount(*model.Account) error {
+	return nil
+}
+
+func (s *PostgresStore) UpdateAccountRole(id int, role string) error {
+	_, err := s.db.Exec(`UPDATE account SET role = $1 WHERE id = $2`, role, id)
+	return err
+}
+
+func (s *PostgresStore) DeleteAccount(id int) error {
+	_, err := s.db.Exec(`DELETE FROM account WHERE id = $1`, id)
+	return err
+}
+
+func (s *PostgresStore) GetAccountByNumber(number int) (*model.Account, error) {
+	acc := new(model.Account)
+	if err := s.db.Get(acc, `SELECT * FROM account WHERE number = $1`, number); err != nil {
+		return nil, fmt.Errorf("account with number %d not found: %w", number, err)
+	}
+	return acc, nil
+}
+
+func (s *PostgresStore) GetAccountByID(id int) (*model.Account, error) {
+	acc := new(model.Account)
+	if err := s.db.Get(acc, `SELECT * FROM account WHERE id = $1`, id); err != nil {
+		return nil, fmt.Errorf("account %d not found: %w", id, err)
+	}
+	return acc, nil
+}
+
+func (s *PostgresStore) GetAccounts() ([]*model.Account, error) {
+	accounts := []*model.Account{}
+	if err := s.db.Select(&accounts, `SELECT * FROM account`); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+func (s *PostgresStore) UpdatePasswordHash(id int, hash string) error {
+	_, err := s.db.Exec(`UPDATE account SET encrypted_password = $1 WHERE id = $2`, hash, id)
+	return err
+}
+
+func (s *PostgresStore) CreatePasswordReset(accountID int, tokenHash string, expiresAt time.Time) error {
+	_, err := s.db.Exec(
+		`INSERT INTO password_resets (account_id, token_hash, expires_at) VALUES ($1, $2, $3)`,
+		accountID, tokenHash, expiresAt)
+	return err
+}
+
+func (s *PostgresStore) GetPasswordResetByTokenHash(tokenHash string) (*model.PasswordReset, error) {
+	reset := new(model.PasswordReset)
+	if err := s.db.Get(reset, `SELECT * FROM password_resets WHERE token_hash = $1`, tokenHash); err != nil {
+		return nil, err
+	}
+	return reset, nil
+}
+
+func (s *PostgresStore) ConsumePasswordReset(id int) error {
+	_, err := s.db.Exec(`UPDATE password_resets SET consumed_at = now() WHERE id = $1`, id)
+	return err
+}
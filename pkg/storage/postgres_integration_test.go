@@ -0,0 +1,79 @@
+//go:build integration
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/Flamme97/bankGO/pkg/model"
+)
+
+// TestPostgresStoreIntegration exercises PostgresStore against a real
+// Postgres instance. Run with `go test -tags=integration ./pkg/storage/...`.
+func TestPostgresStoreIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:16-alpine",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     "bankgo",
+				"POSTGRES_PASSWORD": "bankgo",
+				"POSTGRES_DB":       "bankgo",
+			},
+			WaitingFor: wait.ForListeningPort("5432/tcp"),
+		},
+		Started: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to start postgres container: %v", err)
+	}
+	defer container.Terminate(ctx)
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		t.Fatalf("failed to get container host: %v", err)
+	}
+	port, err := container.MappedPort(ctx, "5432")
+	if err != nil {
+		t.Fatalf("failed to get mapped port: %v", err)
+	}
+
+	t.Setenv("DB_URL", fmt.Sprintf(
+		"postgres://bankgo:bankgo@%s:%s/bankgo?sslmode=disable", host, port.Port()))
+	t.Setenv("MIGRATIONS_PATH", "file://../../db/migrations")
+
+	store, err := NewPostgresStore()
+	if err != nil {
+		t.Fatalf("NewPostgresStore returned error: %v", err)
+	}
+	if err := store.Init(); err != nil {
+		t.Fatalf("Init returned error: %v", err)
+	}
+
+	acc, err := model.NewAccount("Jane", "Doe", "s3cret")
+	if err != nil {
+		t.Fatalf("failed to build account: %v", err)
+	}
+	acc.Email = "jane@example.com"
+	if err := store.CreateAccount(acc); err != nil {
+		t.Fatalf("CreateAccount returned error: %v", err)
+	}
+
+	got, err := store.GetAccountByNumber(int(acc.Number))
+	if err != nil {
+		t.Fatalf("GetAccountByNumber returned error: %v", err)
+	}
+	if got.FirstName != acc.FirstName {
+		t.Errorf("FirstName = %q, want %q", got.FirstName, acc.FirstName)
+	}
+	if got.Email != acc.Email {
+		t.Errorf("Email = %q, want %q", got.Email, acc.Email)
+	}
+}
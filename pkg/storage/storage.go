@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/Flamme97/bankGO/pkg/model"
+)
+
+type Storage interface {
+	CreateAccount(*model.Account) error
+	DeleteAccount(int) error
+	UpdateAccount(*model.Account) error
+	GetAccounts() ([]*model.Account, error)
+	GetAccountByID(int) (*model.Account, error)
+	GetAccountByNumber(int) (*model.Account, error)
+	UpdateAccountRole(id int, role string) error
+	RevokeToken(jti string, expiresAt time.Time) error
+	IsTokenRevoked(jti string) (bool, error)
+	Transfer(ctx context.Context, fromID, toID int, amount int64) (*model.Transfer, error)
+	GetLedger(accountID, limit, offset int) ([]*model.LedgerEntry, error)
+	UpdatePasswordHash(id int, hash string) error
+	CreatePasswordReset(accountID int, tokenHash string, expiresAt time.Time) error
+	GetPasswordResetByTokenHash(tokenHash string) (*model.PasswordReset, error)
+	ConsumePasswordReset(id int) error
+}